@@ -0,0 +1,284 @@
+// Package de implements Differential Evolution (DE), including the
+// self-adaptive jDE variant, over real-valued genomes.
+//
+// DE operates on the existing Population/Genome/View abstractions from the
+// parent evo package: a generation is read through a View (so the usual
+// statistics are available for free) and advanced by mutation, crossover and
+// greedy selection against Genome.Fitness().
+package de
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/bliksemstraal/evo"
+)
+
+// Vector is implemented by genomes that represent points in a real-valued
+// search space. DE mutates and recombines coordinates directly, so any
+// genome used with this package must expose them along with the bounds they
+// are constrained to.
+type Vector interface {
+	evo.Genome
+
+	// Dim returns the number of coordinates in the vector.
+	Dim() int
+
+	// At returns the value of the i'th coordinate.
+	At(i int) float64
+
+	// SetAt sets the value of the i'th coordinate.
+	SetAt(i int, x float64)
+
+	// Bounds returns the lower and upper bound of the i'th coordinate.
+	Bounds(i int) (lo, hi float64)
+
+	// Clone returns an independent copy of the vector, used to build trial
+	// vectors without mutating donors or targets in place.
+	Clone() Vector
+}
+
+// Strategy selects the mutation scheme used to build a donor vector.
+type Strategy int
+
+const (
+	// RandOneBin mutates v = x_r1 + F*(x_r2 - x_r3).
+	RandOneBin Strategy = iota
+
+	// BestOneBin mutates v = x_best + F*(x_r1 - x_r2).
+	BestOneBin
+
+	// CurrentToBestOneBin mutates v = x_i + F*(x_best - x_i) + F*(x_r1 - x_r2).
+	CurrentToBestOneBin
+
+	// RandTwoBin mutates v = x_r1 + F*(x_r2 - x_r3) + F*(x_r4 - x_r5).
+	RandTwoBin
+)
+
+// jDE self-adaptation parameters, as proposed by Brest et al. Each genome's F
+// and CR are allowed to mutate with these probabilities before the genome is
+// used as a target, and the mutated values are kept whenever the trial they
+// produced survives.
+const (
+	tau1 = 0.1
+	tau2 = 0.1
+
+	fLo, fHi = 0.1, 0.9
+)
+
+// DE performs differential evolution over a generation of Vector genomes.
+//
+// The zero value is not usable; F, CR and Strategy must be set, or JDE must
+// be enabled to have them self-adapt. When Rand is nil, each Step uses a new
+// source seeded from the package-level math/rand source.
+type DE struct {
+	F        float64
+	CR       float64
+	Strategy Strategy
+
+	// JDE enables per-genome self-adaptation of F and CR, as described by
+	// Brest et al., "Self-Adapting Control Parameters in Differential
+	// Evolution" (2006). When enabled, F and CR passed above are only used to
+	// seed genomes that have not been evolved yet.
+	JDE bool
+
+	Rand *rand.Rand
+
+	// params holds the self-adapted (F, CR) pair for each population slot,
+	// keyed by index rather than genome identity: the winner of a trial
+	// takes the loser's slot in Step, so indexing by slot is what makes the
+	// winning parameters carry over to the next generation.
+	params map[int]*jdeParams
+}
+
+type jdeParams struct {
+	f, cr float64
+}
+
+func (d *DE) rng() *rand.Rand {
+	if d.Rand == nil {
+		return rand.New(rand.NewSource(rand.Int63()))
+	}
+	return d.Rand
+}
+
+// paramsFor returns the (F, CR) pair to use for the genome at slot i,
+// self-adapting them first when JDE is enabled. The adapted pair is not
+// stored; Step stores it in the slot only when the trial survives.
+func (d *DE) paramsFor(rng *rand.Rand, i int) (f, cr float64) {
+	if !d.JDE {
+		return d.F, d.CR
+	}
+
+	if d.params == nil {
+		d.params = make(map[int]*jdeParams)
+	}
+
+	p, ok := d.params[i]
+	if !ok {
+		p = &jdeParams{f: d.F, cr: d.CR}
+		d.params[i] = p
+	}
+
+	f, cr = p.f, p.cr
+	if rng.Float64() < tau1 {
+		f = fLo + rng.Float64()*fHi
+	}
+	if rng.Float64() < tau2 {
+		cr = rng.Float64()
+	}
+
+	return f, cr
+}
+
+// Step advances one generation of pop, returning the next generation. pop is
+// left untouched; the caller decides how the result replaces the current
+// population.
+//
+// Step panics if pop is too small for d.Strategy to find enough distinct
+// donors for a target (RandTwoBin needs 5 donors besides the target, so a
+// population of at least 6; the *OneBin strategies need 3 or 2 besides the
+// target).
+func (d *DE) Step(pop []Vector) []Vector {
+	if len(pop) <= d.donorCount() {
+		panic(fmt.Sprintf("de: population of %d is too small for %d distinct donors", len(pop), d.donorCount()))
+	}
+
+	rng := d.rng()
+	genomes := make([]evo.Genome, len(pop))
+	for i, v := range pop {
+		genomes[i] = v
+	}
+	view := evo.NewView(genomes...)
+	defer view.Close()
+
+	next := make([]Vector, len(pop))
+	for i, target := range pop {
+		f, cr := d.paramsFor(rng, i)
+
+		donors := d.sample(rng, pop, i)
+		mutant := d.mutate(view, pop, target, donors, f)
+		trial := d.crossover(rng, target, mutant, cr)
+
+		if trial.Fitness() >= target.Fitness() {
+			next[i] = trial
+			if p := d.params[i]; p != nil {
+				p.f, p.cr = f, cr
+			}
+		} else {
+			next[i] = target
+		}
+	}
+
+	return next
+}
+
+// Stagnated reports whether a population described by view has converged: its
+// fitness variance has dropped below threshold, so further generations are
+// unlikely to make meaningful progress.
+func Stagnated(view evo.View, threshold float64) bool {
+	return view.Variance() < threshold
+}
+
+// donorCount returns how many distinct donors besides the target a strategy
+// needs. Best-based strategies also use the best genome, which is not
+// excluded from the donors.
+func (d *DE) donorCount() int {
+	switch d.Strategy {
+	case BestOneBin:
+		return 2
+	case CurrentToBestOneBin:
+		return 2
+	case RandTwoBin:
+		return 5
+	default: // RandOneBin
+		return 3
+	}
+}
+
+// sample draws donorCount distinct indexes from pop, excluding exclude.
+func (d *DE) sample(rng *rand.Rand, pop []Vector, exclude int) []int {
+	n := d.donorCount()
+	seen := make(map[int]bool, n+1)
+	seen[exclude] = true
+
+	idx := make([]int, 0, n)
+	for len(idx) < n {
+		r := rng.Intn(len(pop))
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		idx = append(idx, r)
+	}
+	return idx
+}
+
+// mutate builds the donor vector for target according to d.Strategy.
+func (d *DE) mutate(view evo.View, pop []Vector, target Vector, donors []int, f float64) []float64 {
+	dim := target.Dim()
+	out := make([]float64, dim)
+
+	best := view.Max().(Vector)
+
+	switch d.Strategy {
+	case BestOneBin:
+		r1, r2 := pop[donors[0]], pop[donors[1]]
+		for j := 0; j < dim; j++ {
+			out[j] = best.At(j) + f*(r1.At(j)-r2.At(j))
+		}
+
+	case CurrentToBestOneBin:
+		r1, r2 := pop[donors[0]], pop[donors[1]]
+		for j := 0; j < dim; j++ {
+			out[j] = target.At(j) + f*(best.At(j)-target.At(j)) + f*(r1.At(j)-r2.At(j))
+		}
+
+	case RandTwoBin:
+		r1, r2, r3, r4, r5 := pop[donors[0]], pop[donors[1]], pop[donors[2]], pop[donors[3]], pop[donors[4]]
+		for j := 0; j < dim; j++ {
+			out[j] = r1.At(j) + f*(r2.At(j)-r3.At(j)) + f*(r4.At(j)-r5.At(j))
+		}
+
+	default: // RandOneBin
+		r1, r2, r3 := pop[donors[0]], pop[donors[1]], pop[donors[2]]
+		for j := 0; j < dim; j++ {
+			out[j] = r1.At(j) + f*(r2.At(j)-r3.At(j))
+		}
+	}
+
+	for j := 0; j < dim; j++ {
+		lo, hi := target.Bounds(j)
+		out[j] = clamp(out[j], lo, hi)
+	}
+
+	return out
+}
+
+// crossover performs binomial crossover between target and mutant, copying
+// the result onto a clone of target. jrand guarantees at least one component
+// is inherited from mutant.
+func (d *DE) crossover(rng *rand.Rand, target Vector, mutant []float64, cr float64) Vector {
+	dim := target.Dim()
+	trial := target.Clone()
+	jrand := rng.Intn(dim)
+
+	for j := 0; j < dim; j++ {
+		if j == jrand || rng.Float64() < cr {
+			trial.SetAt(j, mutant[j])
+		}
+	}
+
+	return trial
+}
+
+func clamp(x, lo, hi float64) float64 {
+	switch {
+	case x < lo:
+		return lo
+	case x > hi:
+		return hi
+	default:
+		return x
+	}
+}
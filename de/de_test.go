@@ -0,0 +1,109 @@
+package de
+
+import (
+	"math/rand"
+	"testing"
+)
+
+type testVector struct {
+	coords []float64
+}
+
+func (v *testVector) Fitness() float64 {
+	// Negated sphere function: higher fitness means closer to the origin,
+	// so greedy DE selection should never regress it.
+	sum := 0.0
+	for _, c := range v.coords {
+		sum += c * c
+	}
+	return -sum
+}
+
+func (v *testVector) Dim() int                      { return len(v.coords) }
+func (v *testVector) At(i int) float64              { return v.coords[i] }
+func (v *testVector) SetAt(i int, x float64)        { v.coords[i] = x }
+func (v *testVector) Bounds(i int) (lo, hi float64) { return -10, 10 }
+
+func (v *testVector) Clone() Vector {
+	coords := make([]float64, len(v.coords))
+	copy(coords, v.coords)
+	return &testVector{coords: coords}
+}
+
+func newTestPop(n, dim int, rng *rand.Rand) []Vector {
+	pop := make([]Vector, n)
+	for i := range pop {
+		coords := make([]float64, dim)
+		for j := range coords {
+			coords[j] = rng.Float64()*20 - 10
+		}
+		pop[i] = &testVector{coords: coords}
+	}
+	return pop
+}
+
+func TestStepPreservesPopulationSize(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	pop := newTestPop(10, 3, rng)
+
+	de := &DE{F: 0.5, CR: 0.9, Strategy: RandOneBin, Rand: rng}
+	next := de.Step(pop)
+
+	if len(next) != len(pop) {
+		t.Fatalf("got %d genomes, want %d", len(next), len(pop))
+	}
+}
+
+func TestStepGreedySelectionNeverRegresses(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	pop := newTestPop(12, 4, rng)
+
+	de := &DE{F: 0.8, CR: 0.9, Strategy: BestOneBin, Rand: rng}
+
+	before := 0.0
+	for _, v := range pop {
+		before += v.Fitness()
+	}
+
+	next := de.Step(pop)
+
+	after := 0.0
+	for _, v := range next {
+		after += v.Fitness()
+	}
+
+	if after < before {
+		t.Fatalf("mean fitness regressed: before=%v after=%v", before, after)
+	}
+}
+
+// TestJDEParamsDoNotLeak guards against keying the jDE parameter table by
+// genome identity: doing so lets every replaced target linger in the map
+// forever, growing it without bound across generations.
+func TestJDEParamsDoNotLeak(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	pop := newTestPop(10, 3, rng)
+
+	de := &DE{F: 0.5, CR: 0.9, Strategy: RandOneBin, JDE: true, Rand: rng}
+
+	for g := 0; g < 20; g++ {
+		pop = de.Step(pop)
+		if len(de.params) != len(pop) {
+			t.Fatalf("generation %d: len(params)=%d, want %d (population size, should never grow)", g, len(de.params), len(pop))
+		}
+	}
+}
+
+func TestStepPanicsOnTooSmallPopulationForStrategy(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	pop := newTestPop(4, 2, rng) // RandTwoBin needs 5 donors + target = 6
+
+	de := &DE{F: 0.5, CR: 0.9, Strategy: RandTwoBin, Rand: rng}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Step to panic on a population too small for RandTwoBin")
+		}
+	}()
+	de.Step(pop)
+}
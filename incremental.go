@@ -0,0 +1,171 @@
+package evo
+
+import "math"
+
+// Add incorporates a single genome into the view in O(1), updating mean,
+// variance, max and min using Welford's online algorithm instead of
+// rebuilding the view from scratch.
+func (v *View) Add(g Genome) {
+	fit := g.Fitness()
+	idx := len(v.members)
+
+	if idx == 0 {
+		v.max, v.min = 0, 0
+	} else {
+		if fit > v.members[v.max].Fitness() {
+			v.max = idx
+		}
+		if fit < v.members[v.min].Fitness() {
+			v.min = idx
+		}
+	}
+
+	newlen := v.len + 1
+	delta := fit - v.mean
+	deltaN := delta / newlen
+	deltaN2 := deltaN * deltaN
+	term1 := delta * deltaN * v.len
+	v.mean += deltaN
+
+	// higher moments, before m2 is overwritten; same single-pass update
+	// NewView's atomic case uses
+	v.m4 += term1*deltaN2*(newlen*newlen-3*newlen+3) + 6*deltaN2*v.m2 - 4*deltaN*v.m3
+	v.m3 += term1*deltaN*(newlen-2) - 3*deltaN*v.m2
+
+	v.m2 += term1
+	v.len = newlen
+
+	v.members = append(v.members, g)
+	v.invalidateSelectionCache()
+}
+
+// Remove removes g from the view, identified by == (so Genome
+// implementations used with Remove must be comparable, e.g. pointer-based),
+// and updates mean and variance using the reverse of Welford's recurrence:
+// mean' = (n*mean - x)/(n-1), M2' = M2 - (x-mean)*(x-mean'). It reports
+// whether g was found.
+//
+// Finding g is a linear O(n) scan over the members. Removing it is then O(1)
+// (it is swapped with the last member to avoid shifting the slice), but if g
+// was the cached max or min, the cache cannot be repaired incrementally and
+// Remove also does a full O(n) rescan, calling Fitness on every member, to
+// find the new extremum.
+//
+// Remove has no reverse update for the third and fourth central moments (no
+// formula analogous to the mean/M2 one above is implemented), so it poisons
+// m3 and m4 to NaN rather than silently leaving the removed genome's exact
+// contribution baked in forever: Skew and Kurtosis on a view that has had
+// members removed would otherwise look like plausible numbers while being
+// wrong by an unbounded amount. Rebuild the view with NewView if Skew or
+// Kurtosis are needed after a removal.
+func (v *View) Remove(g Genome) bool {
+	idx := -1
+	for i, m := range v.members {
+		if m == g {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return false
+	}
+
+	fit := g.Fitness()
+	n := v.len
+	newlen := n - 1
+
+	last := len(v.members) - 1
+	v.members[idx] = v.members[last]
+	v.members = v.members[:last]
+	v.invalidateSelectionCache()
+
+	if newlen == 0 {
+		v.mean, v.m2, v.m3, v.m4, v.len = 0, 0, 0, 0, 0
+		v.max, v.min = 0, 0
+		return true
+	}
+
+	newmean := (n*v.mean - fit) / newlen
+	v.m2 -= (fit - v.mean) * (fit - newmean)
+	v.mean = newmean
+	v.m3, v.m4 = math.NaN(), math.NaN()
+	v.len = newlen
+
+	if idx == v.max || idx == v.min {
+		v.rescanExtremes()
+		return true
+	}
+
+	// The element that used to sit at `last` was swapped into `idx`; repoint
+	// its cached index if it was the max or min.
+	if v.max == last {
+		v.max = idx
+	}
+	if v.min == last {
+		v.min = idx
+	}
+
+	return true
+}
+
+// rescanExtremes recomputes v.max and v.min from scratch. Called when a
+// removal invalidates the cached extremum and there is no cheaper way to
+// recover it.
+func (v *View) rescanExtremes() {
+	v.max, v.min = 0, 0
+	for i, m := range v.members {
+		if m.Fitness() > v.members[v.max].Fitness() {
+			v.max = i
+		}
+		if m.Fitness() < v.members[v.min].Fitness() {
+			v.min = i
+		}
+	}
+}
+
+// Merge folds other into v in place, using the same Chan et al. pairwise
+// combiner NewView uses when merging sub-population views, and closes other.
+// When v is empty, v takes over other's storage instead and other is not
+// closed. This lets a live view absorb a batch of concurrently produced genomes
+// (itself collected into a View) in a single O(k) step rather than k
+// individual Add calls.
+func (v *View) Merge(other View) {
+	if other.len == 0 {
+		return
+	}
+	if v.len == 0 {
+		*v = other
+		v.invalidateSelectionCache()
+		return
+	}
+
+	delta := other.mean - v.mean
+	newlen := other.len + v.len
+
+	if other.Max().Fitness() > v.Max().Fitness() {
+		v.max = len(v.members) + other.max
+	}
+	if other.Min().Fitness() < v.Min().Fitness() {
+		v.min = len(v.members) + other.min
+	}
+
+	v.mean += delta * (other.len / newlen)
+
+	// higher moments, before m2 is overwritten; nA, nB follow Terriberry's
+	// naming, with A = v and B = other
+	nA, nB := v.len, other.len
+	v.m4 += other.m4 +
+		delta*delta*delta*delta*(nA*nB*(nA*nA-nA*nB+nB*nB))/(newlen*newlen*newlen) +
+		6*delta*delta*(nA*nA*other.m2+nB*nB*v.m2)/(newlen*newlen) +
+		4*delta*(nA*other.m3-nB*v.m3)/newlen
+	v.m3 += other.m3 +
+		delta*delta*delta*(nA*nB*(nA-nB))/(newlen*newlen) +
+		3*delta*(nA*other.m2-nB*v.m2)/newlen
+
+	v.m2 += other.m2 + delta*delta*(other.len*v.len/newlen)
+	v.len = newlen
+	v.members = append(v.members, other.members...)
+	v.invalidateSelectionCache()
+
+	other.Close()
+}
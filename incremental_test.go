@@ -0,0 +1,134 @@
+package evo
+
+import (
+	"math"
+	"testing"
+)
+
+func closeEnough(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestAddMatchesNewView(t *testing.T) {
+	fitnesses := []float64{3, 1, 4, 1, 5, 9, 2, 6}
+
+	want := NewView(genomes(fitnesses...)...)
+	defer want.Close()
+
+	var got View
+	for _, f := range fitnesses {
+		got.Add(fitnessGenome(f))
+	}
+
+	if !closeEnough(got.Mean(), want.Mean()) {
+		t.Errorf("Mean() = %v, want %v", got.Mean(), want.Mean())
+	}
+	if !closeEnough(got.Variance(), want.Variance()) {
+		t.Errorf("Variance() = %v, want %v", got.Variance(), want.Variance())
+	}
+	if got.Max().Fitness() != want.Max().Fitness() {
+		t.Errorf("Max() = %v, want %v", got.Max().Fitness(), want.Max().Fitness())
+	}
+	if got.Min().Fitness() != want.Min().Fitness() {
+		t.Errorf("Min() = %v, want %v", got.Min().Fitness(), want.Min().Fitness())
+	}
+}
+
+func TestRemoveRestoresMeanAndVariance(t *testing.T) {
+	var v View
+	for _, f := range []float64{3, 1, 4, 1, 5} {
+		v.Add(fitnessGenome(f))
+	}
+
+	removed := fitnessGenome(4)
+	if !v.Remove(removed) {
+		t.Fatal("Remove reported the genome was not found")
+	}
+
+	want := NewView(genomes(3, 1, 1, 5)...)
+	defer want.Close()
+
+	if !closeEnough(v.Mean(), want.Mean()) {
+		t.Errorf("Mean() after Remove = %v, want %v", v.Mean(), want.Mean())
+	}
+	if !closeEnough(v.Variance(), want.Variance()) {
+		t.Errorf("Variance() after Remove = %v, want %v", v.Variance(), want.Variance())
+	}
+	if v.Len() != 4 {
+		t.Errorf("Len() after Remove = %d, want 4", v.Len())
+	}
+}
+
+func TestRemoveRescansExtremumWhenNeeded(t *testing.T) {
+	var v View
+	max := fitnessGenome(9)
+	for _, f := range []float64{3, 1, 9, 2} {
+		if f == 9 {
+			v.Add(max)
+		} else {
+			v.Add(fitnessGenome(f))
+		}
+	}
+
+	if !v.Remove(max) {
+		t.Fatal("Remove reported the max genome was not found")
+	}
+	if v.Max().Fitness() != 3 {
+		t.Errorf("Max() after removing the old max = %v, want 3", v.Max().Fitness())
+	}
+}
+
+// TestRemovePoisonsHigherMoments guards against Remove silently leaving the
+// removed genome's exact skew/kurtosis contribution baked in: since there is
+// no reverse update for m3/m4, they must become unusable (NaN) rather than
+// returning a plausible but wrong number.
+func TestRemovePoisonsHigherMoments(t *testing.T) {
+	var v View
+	for _, f := range []float64{3, 1, 4, 1, 5, 9, 2, 6} {
+		v.Add(fitnessGenome(f))
+	}
+	v.Remove(fitnessGenome(4))
+
+	if !math.IsNaN(v.Skew()) {
+		t.Errorf("Skew() after Remove = %v, want NaN", v.Skew())
+	}
+	if !math.IsNaN(v.Kurtosis()) {
+		t.Errorf("Kurtosis() after Remove = %v, want NaN", v.Kurtosis())
+	}
+}
+
+func TestMergeMatchesNewViewOfCombinedMembers(t *testing.T) {
+	a := NewView(genomes(1, 2, 3)...)
+	b := NewView(genomes(4, 5, 6, 7)...)
+
+	a.Merge(b)
+
+	want := NewView(genomes(1, 2, 3, 4, 5, 6, 7)...)
+	defer want.Close()
+
+	if !closeEnough(a.Mean(), want.Mean()) {
+		t.Errorf("Mean() after Merge = %v, want %v", a.Mean(), want.Mean())
+	}
+	if !closeEnough(a.Variance(), want.Variance()) {
+		t.Errorf("Variance() after Merge = %v, want %v", a.Variance(), want.Variance())
+	}
+	if a.Max().Fitness() != want.Max().Fitness() {
+		t.Errorf("Max() after Merge = %v, want %v", a.Max().Fitness(), want.Max().Fitness())
+	}
+	if a.Len() != want.Len() {
+		t.Errorf("Len() after Merge = %d, want %d", a.Len(), want.Len())
+	}
+}
+
+// TestMergeAllowsSelectionAfterward guards against Add/Remove/Merge leaving
+// v.sel nil: a value-receiver selection method called right after Merge must
+// not panic.
+func TestMergeAllowsSelectionAfterward(t *testing.T) {
+	a := NewView(genomes(1, 2, 3)...)
+	b := NewView(genomes(4, 5, 6)...)
+	a.Merge(b)
+
+	if a.Elitist(1)[0].Fitness() != 6 {
+		t.Fatal("Elitist after Merge did not see the merged-in members")
+	}
+}
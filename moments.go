@@ -0,0 +1,100 @@
+package evo
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Quantile returns the q'th quantile (0 <= q <= 1) of fitness, linearly
+// interpolating between the two nearest order statistics. It reuses the
+// fitness-sorted order cached by View for Rank/Elitist selection.
+func (v View) Quantile(q float64) float64 {
+	order := v.order()
+	n := len(order)
+
+	pos := q * float64(n-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+
+	loFit := v.members[order[lo]].Fitness()
+	hiFit := v.members[order[hi]].Fitness()
+	return loFit + (pos-float64(lo))*(hiFit-loFit)
+}
+
+// Median returns the median fitness.
+func (v View) Median() float64 {
+	return v.Quantile(0.5)
+}
+
+// IQR returns the interquartile range of fitness: Quantile(0.75) -
+// Quantile(0.25).
+func (v View) IQR() float64 {
+	return v.Quantile(0.75) - v.Quantile(0.25)
+}
+
+// Genotype is implemented by genomes that can measure their distance to
+// another genome of the same kind. It allows View.Diversity to compute
+// genotypic (rather than fitness) diversity without knowing how any
+// particular search space represents its genomes.
+type Genotype interface {
+	Genome
+
+	// Distance returns a non-negative measure of how different this genome
+	// is from other.
+	Distance(other Genotype) float64
+}
+
+// DiversitySampleThreshold is the view size above which Diversity switches
+// from computing the exact mean pairwise distance to estimating it from a
+// random sample of pairs, since the exact computation is O(n^2).
+const DiversitySampleThreshold = 200
+
+// DiversitySamples is the number of random pairs Diversity draws when
+// estimating diversity for views larger than DiversitySampleThreshold.
+const DiversitySamples = 2000
+
+// Diversity returns the mean pairwise genotypic distance between the
+// members of the view that implement Genotype; members that do not are
+// ignored. For views no larger than DiversitySampleThreshold this is exact;
+// larger views are estimated from DiversitySamples random pairs, trading
+// precision for staying linear rather than quadratic in population size.
+// This is commonly used to detect premature convergence: a view whose
+// fitness variance has collapsed but whose genotypic diversity is still
+// high may simply be in a flat region of the fitness landscape, whereas low
+// genotypic diversity indicates the population has actually converged.
+func (v View) Diversity(rng *rand.Rand) float64 {
+	genotypes := make([]Genotype, 0, len(v.members))
+	for _, m := range v.members {
+		if g, ok := m.(Genotype); ok {
+			genotypes = append(genotypes, g)
+		}
+	}
+
+	n := len(genotypes)
+	if n < 2 {
+		return 0
+	}
+
+	if n <= DiversitySampleThreshold {
+		var sum float64
+		var pairs int
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				sum += genotypes[i].Distance(genotypes[j])
+				pairs++
+			}
+		}
+		return sum / float64(pairs)
+	}
+
+	var sum float64
+	for k := 0; k < DiversitySamples; k++ {
+		i := rng.Intn(n)
+		j := rng.Intn(n)
+		for j == i {
+			j = rng.Intn(n)
+		}
+		sum += genotypes[i].Distance(genotypes[j])
+	}
+	return sum / float64(DiversitySamples)
+}
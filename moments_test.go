@@ -0,0 +1,183 @@
+package evo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// naiveSkewKurtosis computes population skewness and excess kurtosis directly
+// from the definitions, in O(n) with two passes, as a reference independent
+// of the Terriberry single-pass/pairwise recurrences View.Skew/Kurtosis use.
+func naiveSkewKurtosis(fitnesses []float64) (skew, kurtosis float64) {
+	n := float64(len(fitnesses))
+
+	var mean float64
+	for _, f := range fitnesses {
+		mean += f
+	}
+	mean /= n
+
+	var m2, m3, m4 float64
+	for _, f := range fitnesses {
+		d := f - mean
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+
+	skew = math.Sqrt(n) * m3 / math.Pow(m2, 1.5)
+	kurtosis = n*m4/(m2*m2) - 3
+	return skew, kurtosis
+}
+
+// momentCloseEnough is looser than closeEnough: Skew/Kurtosis involve higher
+// powers than Mean/Variance, so the naive and recurrence-based computations
+// accumulate floating-point rounding differently even when both are correct.
+func momentCloseEnough(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestQuantileAndMedian(t *testing.T) {
+	v := NewView(genomes(1, 2, 3, 4, 5)...)
+	defer v.Close()
+
+	if got, want := v.Median(), 3.0; got != want {
+		t.Errorf("Median() = %v, want %v", got, want)
+	}
+	if got, want := v.Quantile(0), 1.0; got != want {
+		t.Errorf("Quantile(0) = %v, want %v", got, want)
+	}
+	if got, want := v.Quantile(1), 5.0; got != want {
+		t.Errorf("Quantile(1) = %v, want %v", got, want)
+	}
+}
+
+func TestIQR(t *testing.T) {
+	v := NewView(genomes(1, 2, 3, 4, 5, 6, 7, 8, 9)...)
+	defer v.Close()
+
+	if got, want := v.IQR(), 4.0; got != want {
+		t.Errorf("IQR() = %v, want %v", got, want)
+	}
+}
+
+// TestMomentsMethodsChainOffNewView guards against these regressing to
+// pointer receivers, which would break calling them directly off the
+// temporary NewView returns.
+func TestMomentsMethodsChainOffNewView(t *testing.T) {
+	if got := NewView(genomes(1, 2, 3)...).Median(); got != 2 {
+		t.Fatalf("Median() = %v, want 2", got)
+	}
+}
+
+type distanceGenome struct {
+	fitnessGenome
+	pos float64
+}
+
+func (g distanceGenome) Distance(other Genotype) float64 {
+	o := other.(distanceGenome)
+	d := g.pos - o.pos
+	if d < 0 {
+		d = -d
+	}
+	return d
+}
+
+func TestDiversityExactForSmallViews(t *testing.T) {
+	members := []Genome{
+		distanceGenome{0, 0},
+		distanceGenome{0, 10},
+	}
+	v := NewView(members...)
+	defer v.Close()
+
+	rng := rand.New(rand.NewSource(1))
+	if got, want := v.Diversity(rng), 10.0; got != want {
+		t.Errorf("Diversity() = %v, want %v", got, want)
+	}
+}
+
+func randomFitnesses(rng *rand.Rand, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = rng.NormFloat64()*10 + 5
+	}
+	return out
+}
+
+// TestSkewKurtosisMatchNaiveAtomic checks View.Skew/Kurtosis against a naive
+// direct-formula reference for a view built the atomic way, via NewView.
+func TestSkewKurtosisMatchNaiveAtomic(t *testing.T) {
+	rng := rand.New(rand.NewSource(10))
+	fitnesses := randomFitnesses(rng, 50)
+
+	v := NewView(genomes(fitnesses...)...)
+	defer v.Close()
+
+	wantSkew, wantKurtosis := naiveSkewKurtosis(fitnesses)
+	if !momentCloseEnough(v.Skew(), wantSkew) {
+		t.Errorf("Skew() = %v, want %v", v.Skew(), wantSkew)
+	}
+	if !momentCloseEnough(v.Kurtosis(), wantKurtosis) {
+		t.Errorf("Kurtosis() = %v, want %v", v.Kurtosis(), wantKurtosis)
+	}
+}
+
+// TestSkewKurtosisMatchNaiveAfterAdd checks the same, for a view built up
+// incrementally via View.Add, exercising the single-pass update recurrence.
+func TestSkewKurtosisMatchNaiveAfterAdd(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	fitnesses := randomFitnesses(rng, 50)
+
+	var v View
+	for _, f := range fitnesses {
+		v.Add(fitnessGenome(f))
+	}
+
+	wantSkew, wantKurtosis := naiveSkewKurtosis(fitnesses)
+	if !momentCloseEnough(v.Skew(), wantSkew) {
+		t.Errorf("Skew() = %v, want %v", v.Skew(), wantSkew)
+	}
+	if !momentCloseEnough(v.Kurtosis(), wantKurtosis) {
+		t.Errorf("Kurtosis() = %v, want %v", v.Kurtosis(), wantKurtosis)
+	}
+}
+
+// TestSkewKurtosisMatchNaiveAfterMerge checks the same, for a view built by
+// merging two independently constructed views, exercising the Chan/Terriberry
+// pairwise combiner.
+func TestSkewKurtosisMatchNaiveAfterMerge(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+	a := randomFitnesses(rng, 30)
+	b := randomFitnesses(rng, 20)
+
+	va := NewView(genomes(a...)...)
+	vb := NewView(genomes(b...)...)
+	va.Merge(vb)
+	defer va.Close()
+
+	wantSkew, wantKurtosis := naiveSkewKurtosis(append(append([]float64{}, a...), b...))
+	if !momentCloseEnough(va.Skew(), wantSkew) {
+		t.Errorf("Skew() = %v, want %v", va.Skew(), wantSkew)
+	}
+	if !momentCloseEnough(va.Kurtosis(), wantKurtosis) {
+		t.Errorf("Kurtosis() = %v, want %v", va.Kurtosis(), wantKurtosis)
+	}
+}
+
+func TestDiversityIgnoresNonGenotypeMembers(t *testing.T) {
+	members := []Genome{
+		distanceGenome{0, 0},
+		distanceGenome{0, 10},
+		fitnessGenome(42), // no Distance method; must be skipped, not counted
+	}
+	v := NewView(members...)
+	defer v.Close()
+
+	rng := rand.New(rand.NewSource(1))
+	if got, want := v.Diversity(rng), 10.0; got != want {
+		t.Errorf("Diversity() = %v, want %v", got, want)
+	}
+}
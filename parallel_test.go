@@ -0,0 +1,83 @@
+package evo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestNewViewParallelMatchesNewView(t *testing.T) {
+	fitnesses := make([]float64, 500)
+	for i := range fitnesses {
+		fitnesses[i] = float64(i%37) - 18
+	}
+
+	want := NewView(genomes(fitnesses...)...)
+	defer want.Close()
+
+	got := NewViewParallel(context.Background(), 4, genomes(fitnesses...)...)
+	defer got.Close()
+
+	if !closeEnough(got.Mean(), want.Mean()) {
+		t.Errorf("Mean() = %v, want %v", got.Mean(), want.Mean())
+	}
+	if !closeEnough(got.Variance(), want.Variance()) {
+		t.Errorf("Variance() = %v, want %v", got.Variance(), want.Variance())
+	}
+	if got.Max().Fitness() != want.Max().Fitness() {
+		t.Errorf("Max() = %v, want %v", got.Max().Fitness(), want.Max().Fitness())
+	}
+	if got.Min().Fitness() != want.Min().Fitness() {
+		t.Errorf("Min() = %v, want %v", got.Min().Fitness(), want.Min().Fitness())
+	}
+	if got.Len() != want.Len() {
+		t.Errorf("Len() = %d, want %d", got.Len(), want.Len())
+	}
+}
+
+// TestNewViewParallelHandlesAlreadyCancelledContext guards against the
+// first-chunk-always-runs exception regressing: an already-cancelled ctx
+// must still produce a usable view over a non-empty subs.
+func TestNewViewParallelHandlesAlreadyCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	v := NewViewParallel(ctx, 4, genomes(1, 2, 3, 4, 5)...)
+	defer v.Close()
+
+	if v.Len() == 0 {
+		t.Fatal("NewViewParallel with an already-cancelled ctx returned an empty view")
+	}
+}
+
+func benchmarkSubs(n int) []Genome {
+	subs := make([]Genome, n)
+	for i := range subs {
+		subs[i] = fitnessGenome(float64(i))
+	}
+	return subs
+}
+
+func BenchmarkNewView(b *testing.B) {
+	subs := benchmarkSubs(10000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := NewView(subs...)
+		v.Close()
+	}
+}
+
+func BenchmarkNewViewParallel(b *testing.B) {
+	subs := benchmarkSubs(10000)
+
+	for _, workers := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				v := NewViewParallel(context.Background(), workers, subs...)
+				v.Close()
+			}
+		})
+	}
+}
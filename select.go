@@ -0,0 +1,289 @@
+package evo
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// SelectionStrategy identifies one of the selection schemes implemented by
+// View.Select.
+type SelectionStrategy int
+
+const (
+	// FUSSStrategy implements the Fixed Uniform Selection Scheme: a random
+	// fitness target is drawn and the member closest to it is returned.
+	FUSSStrategy SelectionStrategy = iota
+
+	// TournamentStrategy draws a random subset of members and returns the
+	// fittest of the subset.
+	TournamentStrategy
+
+	// RouletteStrategy performs fitness-proportionate (roulette-wheel)
+	// selection.
+	RouletteStrategy
+
+	// StochasticUniversalStrategy draws n members in a single pass using
+	// evenly spaced pointers over the cumulative fitness, reducing the
+	// sampling bias roulette selection exhibits for small n.
+	StochasticUniversalStrategy
+
+	// RankStrategy performs fitness-proportionate selection over ranks
+	// rather than raw fitness, making it insensitive to fitness scaling.
+	RankStrategy
+
+	// ElitistStrategy returns the n fittest members, unconditionally.
+	ElitistStrategy
+)
+
+// selectionCache holds the lazily computed, monotonically increasing
+// cumulative weight table used by Roulette/SUS selection, along with the
+// sorted-by-fitness order used by Rank/Elitist selection.
+//
+// View.sel is allocated by NewView, or by Add/Remove/Merge on a view that
+// has none, and the selection methods never reassign it;
+// Roulette/StochasticUniversalSampling/Rank/Elitist
+// mutate its fields through that already-shared pointer instead of
+// reassigning v.sel itself. That is what lets those methods keep the value
+// receiver every other View method uses (so a view can be used fluently off
+// a temporary, e.g. pop.View().Roulette(rng)) while still caching across
+// calls on the same underlying View.
+type selectionCache struct {
+	cumulative []float64 // prefix sums of (possibly shifted) fitness
+	order      []int     // indexes into members, sorted by ascending fitness
+}
+
+// invalidateSelectionCache clears any cached selection tables after v's
+// members change. It writes through the existing v.sel pointer when one is
+// already allocated (the common case, since NewView always allocates one)
+// rather than reassigning v.sel, keeping Add/Remove/Merge consistent with
+// the value-receiver selection methods above.
+func (v *View) invalidateSelectionCache() {
+	if v.sel == nil {
+		v.sel = &selectionCache{}
+		return
+	}
+	v.sel.cumulative = nil
+	v.sel.order = nil
+}
+
+// FUSSLimit is the default search radius used by View.Select for
+// FUSSStrategy. Callers with domain knowledge of their fitness landscape
+// should call FUSS directly with a limit suited to it.
+const FUSSLimit = 1.0
+
+// FUSS implements the Fixed Uniform Selection Scheme: a random fitness target
+// f* is drawn from [min, min+limit] (or [min, max] when the view's fitness
+// range is narrower than limit) and the member whose fitness is closest to f*
+// is returned. Because View already caches min and max, this comes down to a
+// single linear scan.
+func (v View) FUSS(rng *rand.Rand, limit float64) Genome {
+	min := v.Min().Fitness()
+	max := v.Max().Fitness()
+
+	hi := min + limit
+	if v.Range() <= limit {
+		hi = max
+	}
+
+	target := min + rng.Float64()*(hi-min)
+
+	best := v.members[0]
+	bestDist := math.Abs(best.Fitness() - target)
+	for _, m := range v.members[1:] {
+		if d := math.Abs(m.Fitness() - target); d < bestDist {
+			best, bestDist = m, d
+		}
+	}
+	return best
+}
+
+// Tournament draws k distinct members at random and returns the fittest of
+// them. Distinctness is enforced with a partial Fisher-Yates shuffle over
+// member indexes, so Tournament(rng, len(v.members)) degenerates exactly to
+// "the fittest member of the whole view" rather than merely the fittest of
+// len(v.members) draws-with-replacement.
+func (v View) Tournament(rng *rand.Rand, k int) Genome {
+	if k > len(v.members) {
+		k = len(v.members)
+	}
+
+	idx := make([]int, len(v.members))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	var best Genome
+	for i := 0; i < k; i++ {
+		j := i + rng.Intn(len(idx)-i)
+		idx[i], idx[j] = idx[j], idx[i]
+
+		if m := v.members[idx[i]]; best == nil || m.Fitness() > best.Fitness() {
+			best = m
+		}
+	}
+	return best
+}
+
+// cumulativeFitness lazily builds and caches a prefix sum over fitness, so
+// that a single binary search drives each Roulette/SUS draw instead of a
+// linear scan. When any fitness is negative, every fitness is shifted up by
+// -min first, so the least fit member gets weight zero. Fitness is never
+// sign-flipped: fitter members always get more weight, and there is no
+// minimization mode. It only ever
+// writes through v.sel, never to v.sel itself, so it works with a value
+// receiver.
+func (v View) cumulativeFitness() []float64 {
+	if v.sel.cumulative != nil {
+		return v.sel.cumulative
+	}
+
+	min := v.Min().Fitness()
+	offset := 0.0
+	if min < 0 {
+		// Shift all weights positive; roulette selection is undefined over
+		// negative weights.
+		offset = -min
+	}
+
+	cum := make([]float64, len(v.members))
+	sum := 0.0
+	for i, m := range v.members {
+		sum += m.Fitness() + offset
+		cum[i] = sum
+	}
+
+	v.sel.cumulative = cum
+	return cum
+}
+
+// Roulette performs fitness-proportionate selection: a member is drawn with
+// probability proportional to its fitness (shifted as cumulativeFitness
+// describes when any fitness is negative). The cumulative weight table is
+// built once and reused across draws, so repeated calls are O(log n).
+func (v View) Roulette(rng *rand.Rand) Genome {
+	cum := v.cumulativeFitness()
+	total := cum[len(cum)-1]
+	r := rng.Float64() * total
+	i := sort.Search(len(cum), func(i int) bool { return cum[i] >= r })
+	if i == len(cum) {
+		i = len(cum) - 1
+	}
+	return v.members[i]
+}
+
+// StochasticUniversalSampling draws n members in a single pass using evenly
+// spaced pointers over the cumulative fitness table, which reduces the
+// sampling variance roulette selection has for small n.
+func (v View) StochasticUniversalSampling(rng *rand.Rand, n int) []Genome {
+	cum := v.cumulativeFitness()
+	total := cum[len(cum)-1]
+	step := total / float64(n)
+	start := rng.Float64() * step
+
+	out := make([]Genome, n)
+	i := 0
+	for k := 0; k < n; k++ {
+		r := start + step*float64(k)
+		for cum[i] < r && i < len(cum)-1 {
+			i++
+		}
+		out[k] = v.members[i]
+	}
+	return out
+}
+
+// order lazily builds and caches the permutation of members sorted by
+// ascending fitness, used by Rank and Elitist selection. Like
+// cumulativeFitness, it only ever writes through v.sel.
+func (v View) order() []int {
+	if v.sel.order != nil {
+		return v.sel.order
+	}
+
+	idx := make([]int, len(v.members))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(a, b int) bool {
+		return v.members[idx[a]].Fitness() < v.members[idx[b]].Fitness()
+	})
+
+	v.sel.order = idx
+	return idx
+}
+
+// Rank performs fitness-proportionate selection over ranks rather than raw
+// fitness values, making the outcome insensitive to the scale or sign of the
+// fitness function.
+func (v View) Rank(rng *rand.Rand) Genome {
+	order := v.order()
+	n := len(order)
+
+	// Weight of rank r (0 = worst) is r+1, so cumulative sum is a triangular
+	// number; no need to cache a separate table for this.
+	total := float64(n) * float64(n+1) / 2
+	r := rng.Float64() * total
+
+	sum := 0.0
+	for rank, i := range order {
+		sum += float64(rank + 1)
+		if sum >= r {
+			return v.members[i]
+		}
+	}
+	return v.members[order[n-1]]
+}
+
+// Elitist returns the n fittest members of the view, unconditionally.
+func (v View) Elitist(n int) []Genome {
+	order := v.order()
+	if n > len(order) {
+		n = len(order)
+	}
+
+	out := make([]Genome, n)
+	for i := 0; i < n; i++ {
+		out[i] = v.members[order[len(order)-1-i]]
+	}
+	return out
+}
+
+// Select dispatches to one of the selection schemes above and returns the
+// result as a new View over n selected members, reusing the same pool as
+// NewView. For strategies that select a single member per draw (FUSS,
+// Tournament, Roulette, Rank) n draws are made independently; Elitist and SUS
+// already select n members directly.
+func (v View) Select(rng *rand.Rand, strategy SelectionStrategy, n int) View {
+	picked := make([]Genome, 0, n)
+
+	switch strategy {
+	case FUSSStrategy:
+		for i := 0; i < n; i++ {
+			picked = append(picked, v.FUSS(rng, FUSSLimit))
+		}
+
+	case TournamentStrategy:
+		for i := 0; i < n; i++ {
+			picked = append(picked, v.Tournament(rng, 2))
+		}
+
+	case RouletteStrategy:
+		for i := 0; i < n; i++ {
+			picked = append(picked, v.Roulette(rng))
+		}
+
+	case StochasticUniversalStrategy:
+		picked = append(picked, v.StochasticUniversalSampling(rng, n)...)
+
+	case RankStrategy:
+		for i := 0; i < n; i++ {
+			picked = append(picked, v.Rank(rng))
+		}
+
+	case ElitistStrategy:
+		picked = append(picked, v.Elitist(n)...)
+	}
+
+	return NewView(picked...)
+}
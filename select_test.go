@@ -0,0 +1,112 @@
+package evo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestSelectionMethodsChainOffNewView guards against the selection methods
+// regressing to pointer receivers: NewView returns a View by value, and
+// calling one of these directly off that temporary must compile and work
+// without the caller needing to bind it to a variable first.
+func TestSelectionMethodsChainOffNewView(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	if g := NewView(genomes(1, 2, 3, 4, 5)...).FUSS(rng, 1); g == nil {
+		t.Fatal("FUSS returned nil")
+	}
+	if g := NewView(genomes(1, 2, 3, 4, 5)...).Tournament(rng, 2); g == nil {
+		t.Fatal("Tournament returned nil")
+	}
+	if g := NewView(genomes(1, 2, 3, 4, 5)...).Roulette(rng); g == nil {
+		t.Fatal("Roulette returned nil")
+	}
+	if gs := NewView(genomes(1, 2, 3, 4, 5)...).StochasticUniversalSampling(rng, 3); len(gs) != 3 {
+		t.Fatalf("StochasticUniversalSampling returned %d genomes, want 3", len(gs))
+	}
+	if g := NewView(genomes(1, 2, 3, 4, 5)...).Rank(rng); g == nil {
+		t.Fatal("Rank returned nil")
+	}
+	if gs := NewView(genomes(1, 2, 3, 4, 5)...).Elitist(2); len(gs) != 2 {
+		t.Fatalf("Elitist returned %d genomes, want 2", len(gs))
+	}
+	if v := NewView(genomes(1, 2, 3, 4, 5)...).Select(rng, RouletteStrategy, 3); v.Len() != 3 {
+		t.Fatalf("Select returned a view of %d, want 3", v.Len())
+	}
+}
+
+func TestElitistReturnsFittest(t *testing.T) {
+	v := NewView(genomes(5, 1, 9, 3, 7)...)
+	defer v.Close()
+
+	got := v.Elitist(2)
+	if len(got) != 2 || got[0].Fitness() != 9 || got[1].Fitness() != 7 {
+		t.Fatalf("Elitist(2) = %v, want [9 7]", got)
+	}
+}
+
+func TestRouletteCacheSurvivesMultipleDraws(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	v := NewView(genomes(1, 2, 3, 4, 5)...)
+	defer v.Close()
+
+	// Each draw reuses the lazily built cumulative table; this would panic
+	// or misbehave if the cache were lost between calls.
+	for i := 0; i < 50; i++ {
+		if g := v.Roulette(rng); g == nil {
+			t.Fatalf("draw %d: Roulette returned nil", i)
+		}
+	}
+}
+
+// TestTournamentOfWholeViewReturnsTheFittest guards against Tournament
+// sampling with replacement: drawing k == len(v.members) must degenerate to
+// "the fittest member of the whole view" every time, which only holds if
+// every member is actually considered once.
+func TestTournamentOfWholeViewReturnsTheFittest(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	v := NewView(genomes(5, 1, 9, 3, 7)...)
+	defer v.Close()
+
+	for i := 0; i < 50; i++ {
+		if g := v.Tournament(rng, v.Len()); g.Fitness() != 9 {
+			t.Fatalf("Tournament(rng, %d) = %v, want 9", v.Len(), g.Fitness())
+		}
+	}
+}
+
+// TestTournamentDrawsDistinctMembers checks that a k-sized tournament never
+// counts the same member index twice, by running with k == len(v.members)-1
+// many times: four distinct members out of five always include 4 or 5, so
+// the winner is always one of those two, whereas sampling with replacement
+// would sometimes return 1, 2 or 3.
+func TestTournamentDrawsDistinctMembers(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	v := NewView(genomes(1, 2, 3, 4, 5)...)
+	defer v.Close()
+
+	seen := map[float64]bool{}
+	for i := 0; i < 200; i++ {
+		f := v.Tournament(rng, 4).Fitness()
+		if f < 4 {
+			t.Fatalf("Tournament(rng, 4) returned fitness %v, want 4 or 5", f)
+		}
+		seen[f] = true
+	}
+	if !seen[4] || !seen[5] {
+		t.Fatalf("Tournament(rng, 4) over 200 draws returned %v, want both 4 and 5", seen)
+	}
+}
+
+func TestFUSSRespectsLimit(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	v := NewView(genomes(0, 10, 20, 30, 100)...)
+	defer v.Close()
+
+	for i := 0; i < 50; i++ {
+		g := v.FUSS(rng, 5)
+		if g.Fitness() < 0 || g.Fitness() > 10 {
+			t.Fatalf("FUSS with limit 5 picked fitness %v, want 0 or 10 (closest to a target drawn from [0,5])", g.Fitness())
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package evo
+
+import "sync"
+
+// StreamingPopulation is a population whose view is maintained incrementally
+// as genomes are produced, rather than rebuilt from scratch every
+// generation. It targets producer/consumer pipelines where offspring are
+// evaluated concurrently: each result is folded into the live view as soon
+// as it is ready via View.Add (or View.Merge for a batch), instead of
+// waiting for the whole generation and calling NewView.
+//
+// A StreamingPopulation is safe for concurrent use: Fold, FoldView, Drop and
+// View all take mu, so concurrent producers can fold results in from
+// multiple goroutines without racing on the underlying View's fields.
+type StreamingPopulation struct {
+	mu   sync.Mutex
+	view View
+}
+
+// NewStreamingPopulation creates an empty streaming population.
+func NewStreamingPopulation() *StreamingPopulation {
+	return &StreamingPopulation{}
+}
+
+// Fold incorporates g into the live view in O(1).
+func (s *StreamingPopulation) Fold(g Genome) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.view.Add(g)
+}
+
+// FoldView merges a batch of genomes, already collected into a view, into
+// the live view in a single pairwise combine. See View.Merge.
+func (s *StreamingPopulation) FoldView(batch View) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.view.Merge(batch)
+}
+
+// Drop removes g from the live view. See View.Remove for the cost of
+// removing the current max or min member.
+func (s *StreamingPopulation) Drop(g Genome) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.view.Remove(g)
+}
+
+// View returns a snapshot of the live, incrementally maintained view of the
+// population. The snapshot owns an independent copy of the member slice and
+// its own selection cache, unlike a view obtained from NewView's pool, so
+// that closing it (the usual thing to do with a view obtained elsewhere in
+// this package) cannot nil out genomes that are still part of this
+// StreamingPopulation's live state.
+func (s *StreamingPopulation) View() View {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := s.view
+	v.members = append([]Genome(nil), s.view.members...)
+	v.sel = &selectionCache{}
+	return v
+}
@@ -0,0 +1,94 @@
+package evo
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStreamingPopulationFold(t *testing.T) {
+	s := NewStreamingPopulation()
+	for _, f := range []float64{3, 1, 4, 1, 5} {
+		s.Fold(fitnessGenome(f))
+	}
+
+	v := s.View()
+	if v.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", v.Len())
+	}
+	if v.Max().Fitness() != 5 {
+		t.Fatalf("Max() = %v, want 5", v.Max().Fitness())
+	}
+}
+
+// TestStreamingPopulationViewSnapshotIsIndependent guards against View()
+// returning a live alias: closing the snapshot must not corrupt the
+// StreamingPopulation's own state, since Close nils every slot of a view's
+// backing array.
+func TestStreamingPopulationViewSnapshotIsIndependent(t *testing.T) {
+	s := NewStreamingPopulation()
+	s.Fold(fitnessGenome(1))
+	s.Fold(fitnessGenome(2))
+	s.Fold(fitnessGenome(3))
+
+	snapshot := s.View()
+	snapshot.Close()
+
+	after := s.View()
+	if after.Len() != 3 {
+		t.Fatalf("Len() after closing a snapshot = %d, want 3", after.Len())
+	}
+	for _, g := range after.Members() {
+		if g == nil {
+			t.Fatal("a member is nil after closing an unrelated snapshot view")
+		}
+	}
+	if after.Max().Fitness() != 3 {
+		t.Fatalf("Max() after closing a snapshot = %v, want 3", after.Max().Fitness())
+	}
+}
+
+func TestStreamingPopulationDrop(t *testing.T) {
+	s := NewStreamingPopulation()
+	g := fitnessGenome(4)
+	s.Fold(fitnessGenome(1))
+	s.Fold(g)
+	s.Fold(fitnessGenome(9))
+
+	if !s.Drop(g) {
+		t.Fatal("Drop reported the genome was not found")
+	}
+
+	v := s.View()
+	if v.Len() != 2 {
+		t.Fatalf("Len() after Drop = %d, want 2", v.Len())
+	}
+}
+
+// TestStreamingPopulationFoldIsConcurrencySafe exercises the exact scenario
+// StreamingPopulation's doc comment claims to support: many producer
+// goroutines folding results into the same live view at once. Run with
+// -race, this would otherwise report concurrent writes inside View.Add /
+// invalidateSelectionCache.
+func TestStreamingPopulationFoldIsConcurrencySafe(t *testing.T) {
+	s := NewStreamingPopulation()
+
+	const goroutines = 8
+	const perGoroutine = 200
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Fold(fitnessGenome(float64(g*perGoroutine + i)))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	v := s.View()
+	if want := goroutines * perGoroutine; v.Len() != want {
+		t.Fatalf("Len() = %d, want %d", v.Len(), want)
+	}
+}
@@ -0,0 +1,15 @@
+package evo
+
+// fitnessGenome is the simplest possible Genome for exercising View and its
+// extensions in tests: a bare fitness value with no genotype of its own.
+type fitnessGenome float64
+
+func (g fitnessGenome) Fitness() float64 { return float64(g) }
+
+func genomes(fitnesses ...float64) []Genome {
+	out := make([]Genome, len(fitnesses))
+	for i, f := range fitnesses {
+		out[i] = fitnessGenome(f)
+	}
+	return out
+}
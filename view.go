@@ -8,6 +8,15 @@ import (
 
 // Views are cached for reuse in this pool.
 // Reusing views reduces the chance that a new genome slice is allocated.
+//
+// This is a plain sync.Pool rather than something sharded by hand:
+// sync.Pool already keeps a private per-P cache for the common Get/Put
+// path, so the uncontended fast case (the overwhelming majority of calls)
+// never touches a shared lock at all. NewViewParallel takes its chunk views
+// from the pool in the worker goroutines, and Merge returns them to the pool
+// from the calling goroutine. An additional layer of manual round-robin
+// sharding would only add a contended atomic counter to that fast path without actually
+// restoring per-P locality, so it isn't worth it.
 var pool = sync.Pool{
 	New: func() interface{} {
 		var v View
@@ -28,7 +37,11 @@ type View struct {
 	max, min int     // indexes of the max/min genomes
 	mean     float64 // average fitness of all members
 	m2       float64 // sum of squares of deviation from the mean
+	m3       float64 // sum of cubes of deviation from the mean, for skewness
+	m4       float64 // sum of fourth powers of deviation from the mean, for kurtosis
 	len      float64 // len(v.members) as a float64
+
+	sel *selectionCache // lazily built tables backing Roulette/SUS/Rank/Elitist
 }
 
 // NewView creates a view containing the genomes passed as arguments. If a
@@ -47,6 +60,13 @@ func NewView(subs ...Genome) View {
 		v.members = make([]Genome, 0, len(subs))
 	}
 
+	// sel is allocated here when the pooled view has none, and the selection
+	// methods never reassign it; see the comment on selectionCache for why
+	// that matters.
+	if v.sel == nil {
+		v.sel = &selectionCache{}
+	}
+
 	// We calculate the mean and variance during construction so that calls to
 	// the statistics methods take constant time. For each argument passed, we
 	// have two cases:
@@ -69,6 +89,14 @@ func NewView(subs ...Genome) View {
 	//      Algorithms for Computing the Sample Variance: Analysis and
 	//      Recommendations. The American Statistician 37, 242-247.
 	//      http://www.jstor.org/stable/2683386
+	//
+	// The same two cases also maintain the third and fourth central moment
+	// sums (m3, m4) that back Skew and Kurtosis: the atomic case uses the
+	// single-pass update and the population case uses the pairwise
+	// combiner, both from Terriberry [3].
+	//
+	// [3]: Terriberry, Timothy B. (2007). Computing Higher-Order Moments
+	//      Online. https://web.archive.org/web/20140423031833/https://people.xiph.org/~tterribe/notes/homs.html
 	for i := range subs {
 		switch sub := subs[i].(type) {
 
@@ -94,6 +122,17 @@ func NewView(subs ...Genome) View {
 			// mean
 			v.mean += delta * (subview.len / newlen)
 
+			// higher moments, before m2 is overwritten; nA, nB below follow
+			// Terriberry's naming, with A = v and B = subview
+			nA, nB := v.len, subview.len
+			v.m4 += subview.m4 +
+				delta*delta*delta*delta*(nA*nB*(nA*nA-nA*nB+nB*nB))/(newlen*newlen*newlen) +
+				6*delta*delta*(nA*nA*subview.m2+nB*nB*v.m2)/(newlen*newlen) +
+				4*delta*(nA*subview.m3-nB*v.m3)/newlen
+			v.m3 += subview.m3 +
+				delta*delta*delta*(nA*nB*(nA-nB))/(newlen*newlen) +
+				3*delta*(nA*subview.m2-nB*v.m2)/newlen
+
 			// sum of squares
 			v.m2 += subview.m2
 			v.m2 += delta * delta * (subview.len * v.len / newlen)
@@ -122,10 +161,17 @@ func NewView(subs ...Genome) View {
 			}
 
 			// mean
-			v.mean += delta / newlen
+			deltaN := delta / newlen
+			deltaN2 := deltaN * deltaN
+			term1 := delta * deltaN * v.len
+			v.mean += deltaN
+
+			// higher moments, before m2 is overwritten
+			v.m4 += term1*deltaN2*(newlen*newlen-3*newlen+3) + 6*deltaN2*v.m2 - 4*deltaN*v.m3
+			v.m3 += term1*deltaN*(newlen-2) - 3*deltaN*v.m2
 
 			// sum of squares
-			v.m2 += delta * delta * (v.len / newlen)
+			v.m2 += term1
 
 			// len
 			v.len = newlen
@@ -141,9 +187,25 @@ func NewView(subs ...Genome) View {
 // a view after it is closed.
 // TODO: panic if a view is used after being closed
 func (v View) Close() {
+	// Nil out each slot, not just truncate the length, so the pooled backing
+	// array doesn't pin references to genomes that are no longer part of any
+	// live view.
+	for i := range v.members {
+		v.members[i] = nil
+	}
 	v.members = v.members[0:0]
 	v.max, v.min = 0, 0
-	v.mean, v.m2, v.len = 0, 0, 0
+	v.mean, v.m2, v.m3, v.m4, v.len = 0, 0, 0, 0, 0
+
+	// Clear the cache through the existing pointer rather than nilling out
+	// v.sel itself: NewView only allocates a new selectionCache when v.sel is
+	// nil, so keeping the pointer around lets a pooled View reuse it instead
+	// of allocating a fresh one every time.
+	if v.sel != nil {
+		v.sel.cumulative = nil
+		v.sel.order = nil
+	}
+
 	pool.Put(v)
 }
 
@@ -182,6 +244,18 @@ func (v View) StdDeviation() float64 {
 	return math.Sqrt(v.m2 / v.len)
 }
 
+// Skew returns the sample skewness of fitness: positive when the
+// distribution has a longer tail above the mean, negative below.
+func (v View) Skew() float64 {
+	return math.Sqrt(v.len) * v.m3 / math.Pow(v.m2, 1.5)
+}
+
+// Kurtosis returns the excess kurtosis of fitness (0 for a normal
+// distribution): positive values indicate heavier tails than normal.
+func (v View) Kurtosis() float64 {
+	return v.len*v.m4/(v.m2*v.m2) - 3
+}
+
 // Len returns the number of genomes in the view.
 func (v View) Len() int {
 	return len(v.members)
@@ -0,0 +1,177 @@
+package evo
+
+import (
+	"container/heap"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// WeightTransform maps a genome's raw fitness to a non-negative sampling
+// weight.
+type WeightTransform func(fitness float64) float64
+
+// IdentityWeight uses the fitness itself as the sampling weight. It requires
+// every fitness in the view to be non-negative.
+func IdentityWeight(fitness float64) float64 {
+	return fitness
+}
+
+// SoftmaxWeight returns a transform implementing Boltzmann/softmax
+// weighting at the given temperature: w = exp(fitness/temperature). Lower
+// temperatures sharpen the distribution towards the fittest members.
+func SoftmaxWeight(temperature float64) WeightTransform {
+	return func(fitness float64) float64 {
+		return math.Exp(fitness / temperature)
+	}
+}
+
+// MinimizingWeight returns a transform for minimization problems, where
+// lower fitness should receive higher weight: w = fmax - f + eps. eps keeps
+// the weight of the worst member strictly positive.
+func MinimizingWeight(fmax, eps float64) WeightTransform {
+	return func(fitness float64) float64 {
+		return fmax - fitness + eps
+	}
+}
+
+// WeightedView augments a View with a Fenwick tree (binary indexed tree)
+// over per-member sampling weights, so that Sample draws a member with
+// probability proportional to its weight and Reweight updates a single
+// member's weight, both in O(log n) instead of the O(n) rebuild a plain
+// prefix-sum table would need on every change.
+type WeightedView struct {
+	view    View
+	weights []float64 // current weight per member, parallel to view.Members()
+	tree    []float64 // 1-indexed Fenwick tree over weights
+}
+
+// NewWeightedView builds a WeightedView over view, deriving each member's
+// weight from its fitness via transform.
+func NewWeightedView(view View, transform WeightTransform) *WeightedView {
+	n := view.Len()
+	weights := make([]float64, n)
+	for i, g := range view.Members() {
+		weights[i] = transform(g.Fitness())
+	}
+	return NewWeightedViewWithWeights(view, weights)
+}
+
+// NewWeightedViewWithWeights builds a WeightedView from explicit,
+// precomputed weights, one per member of view in the same order. Use this
+// for weighting schemes that depend on the whole population rather than a
+// single fitness value, such as RankWeights.
+func NewWeightedViewWithWeights(view View, weights []float64) *WeightedView {
+	w := &WeightedView{
+		view:    view,
+		weights: append([]float64(nil), weights...),
+		tree:    make([]float64, len(weights)+1),
+	}
+	for i, wt := range w.weights {
+		w.add(i, wt)
+	}
+	return w
+}
+
+// RankWeights returns a weight per member of view equal to its fitness
+// rank plus one (1 = worst), for use with NewWeightedViewWithWeights when
+// rank-based rather than raw-fitness weighting is wanted.
+func RankWeights(view View) []float64 {
+	order := view.order()
+	weights := make([]float64, len(order))
+	for rank, idx := range order {
+		weights[idx] = float64(rank + 1)
+	}
+	return weights
+}
+
+// add applies delta to the weight stored at position i (0-indexed).
+func (w *WeightedView) add(i int, delta float64) {
+	for i++; i < len(w.tree); i += i & -i {
+		w.tree[i] += delta
+	}
+}
+
+// Total returns the sum of all member weights.
+func (w *WeightedView) Total() float64 {
+	var sum float64
+	for i := len(w.weights); i > 0; i -= i & -i {
+		sum += w.tree[i]
+	}
+	return sum
+}
+
+// Reweight updates the weight of the i'th member in O(log n).
+func (w *WeightedView) Reweight(i int, weight float64) {
+	w.add(i, weight-w.weights[i])
+	w.weights[i] = weight
+}
+
+// Sample draws a member with probability proportional to its weight, in
+// O(log n), by binary-lifting down the Fenwick tree to find the smallest
+// prefix whose cumulative weight reaches a uniformly drawn target.
+func (w *WeightedView) Sample(rng *rand.Rand) Genome {
+	target := rng.Float64() * w.Total()
+
+	pos := 0
+	for pw := 1 << bits.Len(uint(len(w.weights))); pw > 0; pw >>= 1 {
+		next := pos + pw
+		if next <= len(w.weights) && w.tree[next] <= target {
+			pos = next
+			target -= w.tree[next]
+		}
+	}
+
+	idx := pos
+	if idx >= len(w.weights) {
+		idx = len(w.weights) - 1
+	}
+	return w.view.Members()[idx]
+}
+
+// weightedItem pairs a member index with its weight, for use in the TopK
+// min-heap below.
+type weightedItem struct {
+	idx    int
+	weight float64
+}
+
+type weightHeap []weightedItem
+
+func (h weightHeap) Len() int            { return len(h) }
+func (h weightHeap) Less(i, j int) bool  { return h[i].weight < h[j].weight }
+func (h weightHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *weightHeap) Push(x interface{}) { *h = append(*h, x.(weightedItem)) }
+func (h *weightHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// TopK returns the k members with the highest weight, in descending order,
+// using a size-k min-heap so the whole population need not be sorted.
+func (w *WeightedView) TopK(k int) []Genome {
+	if k > len(w.weights) {
+		k = len(w.weights)
+	}
+
+	h := make(weightHeap, 0, k)
+	for i, wt := range w.weights {
+		if len(h) < k {
+			heap.Push(&h, weightedItem{i, wt})
+		} else if wt > h[0].weight {
+			heap.Pop(&h)
+			heap.Push(&h, weightedItem{i, wt})
+		}
+	}
+
+	members := w.view.Members()
+	out := make([]Genome, len(h))
+	for i := len(h) - 1; i >= 0; i-- {
+		item := heap.Pop(&h).(weightedItem)
+		out[i] = members[item.idx]
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package evo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestWeightedViewTotal(t *testing.T) {
+	v := NewView(genomes(1, 2, 3, 4)...)
+	defer v.Close()
+
+	w := NewWeightedView(v, IdentityWeight)
+	if got, want := w.Total(), 10.0; got != want {
+		t.Fatalf("Total() = %v, want %v", got, want)
+	}
+}
+
+func TestWeightedViewReweight(t *testing.T) {
+	v := NewView(genomes(1, 2, 3, 4)...)
+	defer v.Close()
+
+	w := NewWeightedView(v, IdentityWeight)
+	w.Reweight(0, 11) // was 1, now 11: total should grow by 10
+
+	if got, want := w.Total(), 20.0; got != want {
+		t.Fatalf("Total() after Reweight = %v, want %v", got, want)
+	}
+}
+
+// TestWeightedViewSampleIsProportional checks that Sample's empirical draw
+// frequency roughly tracks each member's share of the total weight.
+func TestWeightedViewSampleIsProportional(t *testing.T) {
+	v := NewView(genomes(1, 3, 6)...) // weights 1:3:6, total 10
+	defer v.Close()
+
+	w := NewWeightedView(v, IdentityWeight)
+	rng := rand.New(rand.NewSource(42))
+
+	counts := map[float64]int{}
+	const draws = 20000
+	for i := 0; i < draws; i++ {
+		counts[w.Sample(rng).Fitness()]++
+	}
+
+	want := map[float64]float64{1: 0.1, 3: 0.3, 6: 0.6}
+	for fitness, wantFrac := range want {
+		gotFrac := float64(counts[fitness]) / draws
+		if diff := gotFrac - wantFrac; diff < -0.03 || diff > 0.03 {
+			t.Errorf("fitness %v drawn %.3f of the time, want ~%.3f", fitness, gotFrac, wantFrac)
+		}
+	}
+}
+
+func TestWeightedViewTopK(t *testing.T) {
+	v := NewView(genomes(5, 1, 9, 3, 7)...)
+	defer v.Close()
+
+	w := NewWeightedView(v, IdentityWeight)
+	got := w.TopK(3)
+	if len(got) != 3 || got[0].Fitness() != 9 || got[1].Fitness() != 7 || got[2].Fitness() != 5 {
+		t.Fatalf("TopK(3) = %v, want [9 7 5]", got)
+	}
+}
+
+func TestRankWeightsOrderedByFitness(t *testing.T) {
+	v := NewView(genomes(30, 10, 20)...)
+	defer v.Close()
+
+	weights := RankWeights(v)
+
+	// members are stored in construction order: 30, 10, 20; ranks (1=worst)
+	// should be 3, 1, 2 respectively.
+	want := []float64{3, 1, 2}
+	for i, w := range want {
+		if weights[i] != w {
+			t.Errorf("weights[%d] = %v, want %v", i, weights[i], w)
+		}
+	}
+}